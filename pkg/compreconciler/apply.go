@@ -0,0 +1,117 @@
+package compreconciler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+)
+
+// attemptResult classifies the outcome of a single write attempt against the cluster so
+// applyResource knows whether to retry, fall back to a different verb, or give up.
+type attemptResult int
+
+const (
+	attemptSucceeded attemptResult = iota
+	attemptConflict
+	attemptRetryable
+	attemptTerminal
+)
+
+func classifyAttempt(err error) attemptResult {
+	switch {
+	case err == nil:
+		return attemptSucceeded
+	case k8serrors.IsConflict(err):
+		return attemptConflict
+	case k8serrors.IsInvalid(err), k8serrors.IsForbidden(err):
+		return attemptTerminal
+	default:
+		return attemptRetryable
+	}
+}
+
+// ReconcileError is the structured error surfaced to a CallbackHandler when applying a
+// rendered resource fails, so operators can tell a transient conflict apart from a real
+// configuration problem without parsing error strings.
+type ReconcileError struct {
+	Kind      string
+	Name      string
+	Namespace string
+	Retryable bool
+	Cause     error
+}
+
+func (e *ReconcileError) Error() string {
+	return fmt.Sprintf("failed to reconcile %s '%s/%s': %s", e.Kind, e.Namespace, e.Name, e.Cause)
+}
+
+func (e *ReconcileError) Unwrap() error {
+	return e.Cause
+}
+
+// applyResource creates or updates a single rendered resource. A resourceVersion
+// conflict (HTTP 409) re-fetches the live object and replays the merge from desired
+// before re-issuing the write, up to maxRetries times with exponential backoff starting
+// at retryDelay. IsNotFound on an update falls back to Create, IsAlreadyExists on a
+// create falls back to Update, and IsInvalid/IsForbidden are treated as terminal.
+func applyResource(ctx context.Context, client dynamic.Interface, desired *unstructured.Unstructured, maxRetries int, retryDelay time.Duration) error {
+	gvr, _ := meta.UnsafeGuessKindToResource(desired.GroupVersionKind())
+	resourceClient := client.Resource(gvr).Namespace(desired.GetNamespace())
+
+	delay := retryDelay
+	var lastErr error
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		err := applyOnce(ctx, resourceClient, desired)
+		switch classifyAttempt(err) {
+		case attemptSucceeded:
+			return nil
+		case attemptTerminal:
+			return &ReconcileError{Kind: desired.GetKind(), Name: desired.GetName(), Namespace: desired.GetNamespace(), Retryable: false, Cause: err}
+		default:
+			lastErr = err
+		}
+
+		if attempt == maxRetries {
+			break
+		}
+		time.Sleep(delay)
+		delay *= 2
+	}
+	return &ReconcileError{Kind: desired.GetKind(), Name: desired.GetName(), Namespace: desired.GetNamespace(), Retryable: true, Cause: lastErr}
+}
+
+func applyOnce(ctx context.Context, resourceClient dynamic.ResourceInterface, desired *unstructured.Unstructured) error {
+	_, err := resourceClient.Create(ctx, desired, metav1.CreateOptions{})
+	if err == nil {
+		return nil
+	}
+	if !k8serrors.IsAlreadyExists(err) {
+		return err
+	}
+	return updateResource(ctx, resourceClient, desired)
+}
+
+// updateResource re-fetches the live object so the write carries its current
+// resourceVersion, then replays the desired-state merge on top of it. This is what
+// makes a 409 conflict retry (in applyResource) safe to simply re-attempt.
+func updateResource(ctx context.Context, resourceClient dynamic.ResourceInterface, desired *unstructured.Unstructured) error {
+	live, err := resourceClient.Get(ctx, desired.GetName(), metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			_, createErr := resourceClient.Create(ctx, desired, metav1.CreateOptions{})
+			return createErr
+		}
+		return err
+	}
+
+	merged := desired.DeepCopy()
+	merged.SetResourceVersion(live.GetResourceVersion())
+	_, err = resourceClient.Update(ctx, merged, metav1.UpdateOptions{})
+	return err
+}
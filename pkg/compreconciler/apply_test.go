@@ -0,0 +1,48 @@
+package compreconciler
+
+import (
+	"errors"
+	"testing"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifyAttempt(t *testing.T) {
+	gr := schema.GroupResource{Group: "apps", Resource: "deployments"}
+
+	t.Run("nil error succeeded", func(t *testing.T) {
+		require.Equal(t, attemptSucceeded, classifyAttempt(nil))
+	})
+
+	t.Run("conflict is retried with a re-fetch", func(t *testing.T) {
+		err := k8serrors.NewConflict(gr, "my-deployment", errors.New("resourceVersion mismatch"))
+		require.Equal(t, attemptConflict, classifyAttempt(err))
+	})
+
+	t.Run("invalid is terminal", func(t *testing.T) {
+		err := k8serrors.NewInvalid(schema.GroupKind{Group: "apps", Kind: "Deployment"}, "my-deployment", nil)
+		require.Equal(t, attemptTerminal, classifyAttempt(err))
+	})
+
+	t.Run("forbidden is terminal", func(t *testing.T) {
+		err := k8serrors.NewForbidden(gr, "my-deployment", errors.New("denied"))
+		require.Equal(t, attemptTerminal, classifyAttempt(err))
+	})
+
+	t.Run("anything else is retryable", func(t *testing.T) {
+		err := k8serrors.NewServiceUnavailable("apiserver unavailable")
+		require.Equal(t, attemptRetryable, classifyAttempt(err))
+	})
+}
+
+func TestReconcileError(t *testing.T) {
+	cause := errors.New("boom")
+	err := &ReconcileError{Kind: "Deployment", Name: "my-deployment", Namespace: "default", Retryable: true, Cause: cause}
+
+	require.ErrorIs(t, err, cause)
+	require.Contains(t, err.Error(), "Deployment")
+	require.Contains(t, err.Error(), "my-deployment")
+}
@@ -0,0 +1,105 @@
+package compreconciler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/kyma-incubator/reconciler/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// CallbackHandler reports a Reconciliation's status back to whoever asked for it.
+// reconcileErr carries the failure behind status == Error, so a remote handler can
+// forward its detail (e.g. a *ReconcileError's Kind/Name/Namespace/Retryable) to the
+// caller instead of just the bare status; it's nil for every other status.
+type CallbackHandler interface {
+	Callback(status Status, reconcileErr error) error
+}
+
+type localCallbackHandler struct {
+	callbackFct func(status Status) error
+	logger      *zap.Logger
+}
+
+func newLocalCallbackHandler(callbackFct func(status Status) error, debug bool) (CallbackHandler, error) {
+	if callbackFct == nil {
+		return nil, fmt.Errorf("callback function cannot be nil")
+	}
+	return &localCallbackHandler{
+		callbackFct: callbackFct,
+		logger:      logger.NewOptionalLogger(debug),
+	}, nil
+}
+
+func (cb *localCallbackHandler) Callback(status Status, reconcileErr error) error {
+	cb.logger.Debug(fmt.Sprintf("Invoking local callback function with status '%s'", status))
+	if reconcileErr != nil {
+		cb.logger.Debug(fmt.Sprintf("Reconciliation failed: %s", reconcileErr))
+	}
+	return cb.callbackFct(status)
+}
+
+type remoteCallbackHandler struct {
+	callbackURL string
+	httpClient  *http.Client
+	logger      *zap.Logger
+}
+
+func newRemoteCallbackHandler(callbackURL string, debug bool) (CallbackHandler, error) {
+	if callbackURL == "" {
+		return nil, fmt.Errorf("callback URL cannot be empty")
+	}
+	return &remoteCallbackHandler{
+		callbackURL: callbackURL,
+		httpClient:  &http.Client{},
+		logger:      logger.NewOptionalLogger(debug),
+	}, nil
+}
+
+// callbackPayload is the JSON body posted to a remoteCallbackHandler's callbackURL.
+// Kind/Name/Namespace/Retryable are only populated when reconcileErr is a
+// *ReconcileError, so operators can distinguish a transient conflict from a real
+// configuration problem without parsing the Error string.
+type callbackPayload struct {
+	Status    Status `json:"status"`
+	Error     string `json:"error,omitempty"`
+	Kind      string `json:"kind,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Retryable bool   `json:"retryable,omitempty"`
+}
+
+func (cb *remoteCallbackHandler) Callback(status Status, reconcileErr error) error {
+	payload := callbackPayload{Status: status}
+	if reconcileErr != nil {
+		payload.Error = reconcileErr.Error()
+		var re *ReconcileError
+		if errors.As(reconcileErr, &re) {
+			payload.Kind = re.Kind
+			payload.Name = re.Name
+			payload.Namespace = re.Namespace
+			payload.Retryable = re.Retryable
+		}
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	cb.logger.Debug(fmt.Sprintf("Sending callback to '%s' with status '%s'", cb.callbackURL, status))
+	resp, err := cb.httpClient.Post(cb.callbackURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("callback to '%s' failed with HTTP status code %d", cb.callbackURL, resp.StatusCode)
+	}
+	return nil
+}
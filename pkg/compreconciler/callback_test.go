@@ -1,10 +1,14 @@
 package compreconciler
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
 	"github.com/kyma-incubator/reconciler/pkg/test"
 	"github.com/stretchr/testify/require"
-	"testing"
 )
 
 func TestRemoteCallbackHandler(t *testing.T) {
@@ -14,13 +18,40 @@ func TestRemoteCallbackHandler(t *testing.T) {
 	t.Run("Test successful remote status update", func(t *testing.T) {
 		rcb, err := newRemoteCallbackHandler("https://httpbin.org/status/200", true)
 		require.NoError(t, err)
-		require.NoError(t, rcb.Callback(Running))
+		require.NoError(t, rcb.Callback(Running, nil))
 	})
 
 	t.Run("Test failed remote status update", func(t *testing.T) {
 		rcb, err := newRemoteCallbackHandler("https://httpbin.org/status/400", true)
 		require.NoError(t, err)
-		require.Error(t, rcb.Callback(Running))
+		require.Error(t, rcb.Callback(Running, nil))
+	})
+}
+
+func TestRemoteCallbackHandlerErrorPayload(t *testing.T) {
+	var received callbackPayload
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		received = callbackPayload{}
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&received))
+	}))
+	defer srv.Close()
+
+	rcb, err := newRemoteCallbackHandler(srv.URL, true)
+	require.NoError(t, err)
+
+	t.Run("a ReconcileError's detail is forwarded", func(t *testing.T) {
+		reconcileErr := &ReconcileError{Kind: "Deployment", Name: "my-deployment", Namespace: "default", Retryable: true, Cause: fmt.Errorf("conflict")}
+		require.NoError(t, rcb.Callback(Error, reconcileErr))
+		require.Equal(t, Error, received.Status)
+		require.Equal(t, "Deployment", received.Kind)
+		require.Equal(t, "my-deployment", received.Name)
+		require.True(t, received.Retryable)
+	})
+
+	t.Run("a plain error still carries its message", func(t *testing.T) {
+		require.NoError(t, rcb.Callback(Error, fmt.Errorf("boom")))
+		require.Equal(t, "boom", received.Error)
+		require.Empty(t, received.Kind)
 	})
 }
 
@@ -32,7 +63,7 @@ func TestLocalCallbackHandler(t *testing.T) {
 			return nil
 		}, true)
 		require.NoError(t, err)
-		require.NoError(t, rcb.Callback(Running))
+		require.NoError(t, rcb.Callback(Running, nil))
 		require.True(t, localFctCalled)
 	})
 
@@ -41,6 +72,6 @@ func TestLocalCallbackHandler(t *testing.T) {
 			return fmt.Errorf("I failed")
 		}, true)
 		require.NoError(t, err)
-		require.Error(t, rcb.Callback(Running))
+		require.Error(t, rcb.Callback(Running, nil))
 	})
 }
\ No newline at end of file
@@ -0,0 +1,90 @@
+package compreconciler
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// contractVersion pairs the payload struct a client of a given contract version sends
+// with how to migrate it forward to the reconciler's current internal representation.
+// migrate is nil for the current version: its factory already produces *Reconciliation,
+// so there's nothing to migrate.
+type contractVersion struct {
+	factory func() ReconciliationModel
+	migrate func(prev, next ReconciliationModel) error
+}
+
+// unsupportedVersionError is returned by model() when the requested contract version
+// has no registered factory, so the HTTP handler can respond with 406 and the list of
+// versions it does understand.
+type unsupportedVersionError struct {
+	requested string
+	supported []string
+}
+
+func (e *unsupportedVersionError) Error() string {
+	return fmt.Sprintf("contract version '%s' is not supported; supported versions: %s",
+		e.requested, strings.Join(e.supported, ", "))
+}
+
+// RegisterContractVersion wires up a contract version: factory produces an empty
+// payload struct for that version to unmarshal into, and migrate (nil for the current
+// version) up-migrates an older payload into the canonical *Reconciliation before it
+// reaches runner.Run. Registering a version with a nil migrate makes it the version
+// reported in the Content-Version response header.
+func (r *ComponentReconciler) RegisterContractVersion(
+	version string,
+	factory func() ReconciliationModel,
+	migrate func(prev, next ReconciliationModel) error,
+) *ComponentReconciler {
+	if r.contractVersions == nil {
+		r.contractVersions = map[string]*contractVersion{}
+	}
+	r.contractVersions[version] = &contractVersion{factory: factory, migrate: migrate}
+	if migrate == nil {
+		r.currentContractVersion = version
+	}
+	return r
+}
+
+func (r *ComponentReconciler) supportedVersions() []string {
+	versions := make([]string, 0, len(r.contractVersions))
+	for version := range r.contractVersions {
+		versions = append(versions, version)
+	}
+	sort.Strings(versions)
+	return versions
+}
+
+// resolveReconciliation unmarshals body into the payload struct registered for
+// contractVersion and migrates it forward to *Reconciliation if it isn't already the
+// current version.
+func (r *ComponentReconciler) resolveReconciliation(contractVersion string, unmarshal func(interface{}) error) (*Reconciliation, error) {
+	cv, ok := r.contractVersions[contractVersion]
+	if !ok {
+		return nil, &unsupportedVersionError{requested: contractVersion, supported: r.supportedVersions()}
+	}
+
+	payload := cv.factory()
+	if err := unmarshal(payload); err != nil {
+		return nil, err
+	}
+	if err := payload.Validate(); err != nil {
+		return nil, err
+	}
+
+	if cv.migrate == nil {
+		current, ok := payload.(*Reconciliation)
+		if !ok {
+			return nil, fmt.Errorf("contract version '%s' is registered as current but its model is not *Reconciliation", contractVersion)
+		}
+		return current, nil
+	}
+
+	current := &Reconciliation{}
+	if err := cv.migrate(payload, current); err != nil {
+		return nil, err
+	}
+	return current, nil
+}
@@ -0,0 +1,74 @@
+package compreconciler
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// v0Payload stands in for an older contract version's payload struct, which
+// resolveReconciliation migrates forward to *Reconciliation via a registered migrate func.
+type v0Payload struct {
+	Component string `json:"component"`
+}
+
+func (m *v0Payload) Validate() error {
+	if m.Component == "" {
+		return fmt.Errorf("field 'component' cannot be empty")
+	}
+	return nil
+}
+
+func TestResolveReconciliation(t *testing.T) {
+	t.Run("current version is returned without migration", func(t *testing.T) {
+		r := &ComponentReconciler{}
+		r.RegisterContractVersion("1", func() ReconciliationModel { return &Reconciliation{} }, nil)
+
+		current, err := r.resolveReconciliation("1", func(payload interface{}) error {
+			payload.(*Reconciliation).Component = "istio"
+			payload.(*Reconciliation).Version = "1.0.0"
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, "istio", current.Component)
+	})
+
+	t.Run("older version is migrated forward", func(t *testing.T) {
+		r := &ComponentReconciler{}
+		r.RegisterContractVersion("1", func() ReconciliationModel { return &Reconciliation{} }, nil)
+		r.RegisterContractVersion("0", func() ReconciliationModel { return &v0Payload{} }, func(prev, next ReconciliationModel) error {
+			next.(*Reconciliation).Component = prev.(*v0Payload).Component
+			next.(*Reconciliation).Version = "1.0.0"
+			return nil
+		})
+
+		current, err := r.resolveReconciliation("0", func(payload interface{}) error {
+			payload.(*v0Payload).Component = "istio"
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, "istio", current.Component)
+	})
+
+	t.Run("unregistered version is rejected", func(t *testing.T) {
+		r := &ComponentReconciler{}
+		r.RegisterContractVersion("1", func() ReconciliationModel { return &Reconciliation{} }, nil)
+
+		_, err := r.resolveReconciliation("99", func(interface{}) error { return nil })
+		require.Error(t, err)
+		var unsupported *unsupportedVersionError
+		require.ErrorAs(t, err, &unsupported)
+		require.Equal(t, []string{"1"}, unsupported.supported)
+	})
+
+	t.Run("payload validation errors are propagated", func(t *testing.T) {
+		r := &ComponentReconciler{}
+		r.RegisterContractVersion("1", func() ReconciliationModel { return &Reconciliation{} }, nil)
+
+		_, err := r.resolveReconciliation("1", func(payload interface{}) error {
+			return nil // Component/Version stay empty, Validate() should fail
+		})
+		require.Error(t, err)
+	})
+}
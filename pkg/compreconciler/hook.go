@@ -0,0 +1,272 @@
+package compreconciler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/kyma-incubator/reconciler/pkg/reconciler/kubernetes/progress"
+)
+
+const (
+	annotationHook             = "helm.sh/hook"
+	annotationHookWeight       = "helm.sh/hook-weight"
+	annotationHookDeletePolicy = "helm.sh/hook-delete-policy"
+)
+
+// HookEvent is one of the lifecycle points a chart's helm.sh/hook annotation can target.
+type HookEvent string
+
+const (
+	HookPreInstall   HookEvent = "pre-install"
+	HookPostInstall  HookEvent = "post-install"
+	HookPreUpgrade   HookEvent = "pre-upgrade"
+	HookPostUpgrade  HookEvent = "post-upgrade"
+	HookPreDelete    HookEvent = "pre-delete"
+	HookPostDelete   HookEvent = "post-delete"
+	HookPreRollback  HookEvent = "pre-rollback"
+	HookPostRollback HookEvent = "post-rollback"
+	HookTest         HookEvent = "test"
+)
+
+// hookEventsForPhase returns the pre/post hook events that bracket a Reconciliation's
+// install action for a given Phase.
+func hookEventsForPhase(phase Phase) (pre, post HookEvent) {
+	switch phase {
+	case PhaseUpgrade:
+		return HookPreUpgrade, HookPostUpgrade
+	case PhaseDelete:
+		return HookPreDelete, HookPostDelete
+	case PhaseRollback:
+		return HookPreRollback, HookPostRollback
+	default:
+		return HookPreInstall, HookPostInstall
+	}
+}
+
+// HookDeletePolicy controls when a hook resource is removed, mirroring Helm's
+// helm.sh/hook-delete-policy annotation.
+type HookDeletePolicy string
+
+const (
+	BeforeHookCreation HookDeletePolicy = "before-hook-creation"
+	HookSucceeded      HookDeletePolicy = "hook-succeeded"
+	HookFailed         HookDeletePolicy = "hook-failed"
+)
+
+type hookResource struct {
+	object         *unstructured.Unstructured
+	events         []HookEvent
+	weight         int
+	deletePolicies []HookDeletePolicy
+}
+
+// HookExecutor parses helm.sh/hook annotations out of the manifests a chart.Provider
+// renders and runs the matching ones in ascending helm.sh/hook-weight order, waiting
+// for each weight bucket to become ready before moving on to the next - the same
+// ordering and readiness contract Helm itself guarantees for hooks.
+type HookExecutor struct {
+	kubeClient kubernetes.Interface
+	interval   time.Duration
+	timeout    time.Duration
+}
+
+func NewHookExecutor(kubeClient kubernetes.Interface, interval, timeout time.Duration) *HookExecutor {
+	return &HookExecutor{kubeClient: kubeClient, interval: interval, timeout: timeout}
+}
+
+// Execute applies every manifest annotated for event, one ascending-weight bucket at a
+// time, waiting for the bucket to become ready before the next one starts.
+func (he *HookExecutor) Execute(ctx context.Context, manifests []*unstructured.Unstructured, event HookEvent) error {
+	buckets, err := groupHooksByWeight(manifests, event)
+	if err != nil {
+		return err
+	}
+
+	for _, bucket := range buckets {
+		for _, hook := range bucket {
+			if hasDeletePolicy(hook, BeforeHookCreation) {
+				if err := he.deleteHookResource(ctx, hook); err != nil {
+					return err
+				}
+			}
+			if err := he.applyHookResource(ctx, hook); err != nil {
+				return err
+			}
+		}
+
+		for _, hook := range bucket {
+			if err := he.waitReady(ctx, hook); err != nil {
+				he.cleanupAfter(ctx, hook, HookFailed)
+				return fmt.Errorf("hook %s/%s for event '%s' did not become ready: %w",
+					hook.object.GetNamespace(), hook.object.GetName(), event, err)
+			}
+			he.cleanupAfter(ctx, hook, HookSucceeded)
+		}
+	}
+	return nil
+}
+
+// groupHooksByWeight returns the hooks targeting event, grouped into ascending-weight
+// buckets so callers can apply and wait on one weight at a time.
+func groupHooksByWeight(manifests []*unstructured.Unstructured, event HookEvent) ([][]*hookResource, error) {
+	byWeight := map[int][]*hookResource{}
+
+	for _, manifest := range manifests {
+		hook, err := parseHook(manifest)
+		if err != nil {
+			return nil, err
+		}
+		if hook == nil || !containsEvent(hook.events, event) {
+			continue
+		}
+		byWeight[hook.weight] = append(byWeight[hook.weight], hook)
+	}
+
+	weights := make([]int, 0, len(byWeight))
+	for weight := range byWeight {
+		weights = append(weights, weight)
+	}
+	sort.Ints(weights)
+
+	buckets := make([][]*hookResource, 0, len(weights))
+	for _, weight := range weights {
+		buckets = append(buckets, byWeight[weight])
+	}
+	return buckets, nil
+}
+
+func parseHook(manifest *unstructured.Unstructured) (*hookResource, error) {
+	annotations := manifest.GetAnnotations()
+	rawEvents, ok := annotations[annotationHook]
+	if !ok {
+		return nil, nil
+	}
+
+	hook := &hookResource{object: manifest}
+	for _, rawEvent := range splitAnnotation(rawEvents) {
+		hook.events = append(hook.events, HookEvent(rawEvent))
+	}
+
+	if rawWeight, ok := annotations[annotationHookWeight]; ok {
+		weight, err := strconv.Atoi(rawWeight)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s annotation on %s/%s: %w",
+				annotationHookWeight, manifest.GetNamespace(), manifest.GetName(), err)
+		}
+		hook.weight = weight
+	}
+
+	if rawPolicies, ok := annotations[annotationHookDeletePolicy]; ok {
+		for _, policy := range splitAnnotation(rawPolicies) {
+			hook.deletePolicies = append(hook.deletePolicies, HookDeletePolicy(policy))
+		}
+	} else {
+		// Mirrors Helm's own default: a hook without an explicit
+		// helm.sh/hook-delete-policy annotation is deleted right before a new hook
+		// resource is created for it, not left behind indefinitely.
+		hook.deletePolicies = []HookDeletePolicy{BeforeHookCreation}
+	}
+
+	return hook, nil
+}
+
+func containsEvent(events []HookEvent, event HookEvent) bool {
+	for _, e := range events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+func hasDeletePolicy(hook *hookResource, policy HookDeletePolicy) bool {
+	for _, p := range hook.deletePolicies {
+		if p == policy {
+			return true
+		}
+	}
+	return false
+}
+
+func splitAnnotation(raw string) []string {
+	var values []string
+	for _, v := range strings.Split(raw, ",") {
+		if trimmed := strings.TrimSpace(v); trimmed != "" {
+			values = append(values, trimmed)
+		}
+	}
+	return values
+}
+
+func (he *HookExecutor) waitReady(ctx context.Context, hook *hookResource) error {
+	gvk := hook.object.GroupVersionKind()
+	err := progress.WaitUntilReady(ctx, he.kubeClient, gvk, hook.object.GetNamespace(), hook.object.GetName(), he.interval, he.timeout)
+	if err != nil && errors.Is(err, progress.ErrNoReadyCheck) {
+		// Helm itself only waits on hook Jobs/Pods; any other kind is considered
+		// ready as soon as it's created.
+		return nil
+	}
+	return err
+}
+
+// applyHookResource creates a hook's manifest. Hooks are, in practice, always Jobs or
+// Pods, so those are the only kinds converted and applied for now.
+func (he *HookExecutor) applyHookResource(ctx context.Context, hook *hookResource) error {
+	switch hook.object.GetKind() {
+	case "Job":
+		job := &batchv1.Job{}
+		if err := scheme.Scheme.Convert(hook.object, job, ctx); err != nil {
+			return err
+		}
+		_, err := he.kubeClient.BatchV1().Jobs(job.Namespace).Create(ctx, job, metav1.CreateOptions{})
+		return err
+	case "Pod":
+		pod := &corev1.Pod{}
+		if err := scheme.Scheme.Convert(hook.object, pod, ctx); err != nil {
+			return err
+		}
+		_, err := he.kubeClient.CoreV1().Pods(pod.Namespace).Create(ctx, pod, metav1.CreateOptions{})
+		return err
+	default:
+		return fmt.Errorf("hook resource %s/%s: unsupported kind %q (only Job and Pod hooks can be executed)",
+			hook.object.GetNamespace(), hook.object.GetName(), hook.object.GetKind())
+	}
+}
+
+// cleanupAfter deletes hook if policy is among its helm.sh/hook-delete-policy values,
+// e.g. removing a hook-succeeded Job once Execute has confirmed it became ready.
+func (he *HookExecutor) cleanupAfter(ctx context.Context, hook *hookResource, policy HookDeletePolicy) {
+	if hasDeletePolicy(hook, policy) {
+		_ = he.deleteHookResource(ctx, hook)
+	}
+}
+
+func (he *HookExecutor) deleteHookResource(ctx context.Context, hook *hookResource) error {
+	var err error
+	switch hook.object.GetKind() {
+	case "Job":
+		propagation := metav1.DeletePropagationBackground
+		err = he.kubeClient.BatchV1().Jobs(hook.object.GetNamespace()).Delete(ctx, hook.object.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagation})
+	case "Pod":
+		err = he.kubeClient.CoreV1().Pods(hook.object.GetNamespace()).Delete(ctx, hook.object.GetName(), metav1.DeleteOptions{})
+	default:
+		return nil
+	}
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
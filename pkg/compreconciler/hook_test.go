@@ -0,0 +1,82 @@
+package compreconciler
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newHookManifest(annotations map[string]string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "batch/v1",
+		"kind":       "Job",
+		"metadata": map[string]interface{}{
+			"name":        "my-job",
+			"namespace":   "default",
+			"annotations": annotations,
+		},
+	}}
+}
+
+func TestParseHook(t *testing.T) {
+	t.Run("defaults to before-hook-creation when no delete-policy annotation is set", func(t *testing.T) {
+		manifest := newHookManifest(map[string]string{annotationHook: string(HookPreInstall)})
+		hook, err := parseHook(manifest)
+		require.NoError(t, err)
+		require.Equal(t, []HookDeletePolicy{BeforeHookCreation}, hook.deletePolicies)
+	})
+
+	t.Run("honours an explicit delete-policy annotation", func(t *testing.T) {
+		manifest := newHookManifest(map[string]string{
+			annotationHook:             string(HookPreInstall),
+			annotationHookDeletePolicy: "hook-succeeded,hook-failed",
+		})
+		hook, err := parseHook(manifest)
+		require.NoError(t, err)
+		require.Equal(t, []HookDeletePolicy{HookSucceeded, HookFailed}, hook.deletePolicies)
+	})
+
+	t.Run("non-hook manifests are ignored", func(t *testing.T) {
+		manifest := newHookManifest(nil)
+		hook, err := parseHook(manifest)
+		require.NoError(t, err)
+		require.Nil(t, hook)
+	})
+
+	t.Run("invalid weight annotation is an error", func(t *testing.T) {
+		manifest := newHookManifest(map[string]string{
+			annotationHook:       string(HookPreInstall),
+			annotationHookWeight: "not-a-number",
+		})
+		_, err := parseHook(manifest)
+		require.Error(t, err)
+	})
+}
+
+func TestGroupHooksByWeight(t *testing.T) {
+	manifests := []*unstructured.Unstructured{
+		newHookManifest(map[string]string{annotationHook: string(HookPreInstall), annotationHookWeight: "5"}),
+		newHookManifest(map[string]string{annotationHook: string(HookPreInstall), annotationHookWeight: "-5"}),
+		newHookManifest(map[string]string{annotationHook: string(HookPostInstall), annotationHookWeight: "0"}),
+	}
+
+	buckets, err := groupHooksByWeight(manifests, HookPreInstall)
+	require.NoError(t, err)
+	require.Len(t, buckets, 2)
+	require.Equal(t, -5, buckets[0][0].weight)
+	require.Equal(t, 5, buckets[1][0].weight)
+}
+
+func TestHasDeletePolicy(t *testing.T) {
+	hook := &hookResource{deletePolicies: []HookDeletePolicy{BeforeHookCreation}}
+	require.True(t, hasDeletePolicy(hook, BeforeHookCreation))
+	require.False(t, hasDeletePolicy(hook, HookSucceeded))
+}
+
+func TestContainsEvent(t *testing.T) {
+	events := []HookEvent{HookPreInstall, HookPostInstall}
+	require.True(t, containsEvent(events, HookPreInstall))
+	require.False(t, containsEvent(events, HookPreDelete))
+}
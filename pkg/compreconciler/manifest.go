@@ -0,0 +1,33 @@
+package compreconciler
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// parseManifest splits a multi-document YAML manifest, as rendered by chart.Provider,
+// into individual unstructured resources so callers can inspect annotations (e.g.
+// helm.sh/hook) before deciding how to apply each one.
+func parseManifest(rawManifest string) ([]*unstructured.Unstructured, error) {
+	decoder := k8syaml.NewYAMLOrJSONDecoder(bytes.NewReader([]byte(rawManifest)), 4096)
+
+	var resources []*unstructured.Unstructured
+	for {
+		var raw map[string]interface{}
+		if err := decoder.Decode(&raw); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("failed to parse rendered manifest: %w", err)
+		}
+		if len(raw) == 0 {
+			continue
+		}
+		resources = append(resources, &unstructured.Unstructured{Object: raw})
+	}
+	return resources, nil
+}
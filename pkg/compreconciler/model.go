@@ -0,0 +1,78 @@
+package compreconciler
+
+import (
+	"fmt"
+	"time"
+)
+
+// ReconciliationModel is the interface every contract-version payload struct must
+// implement so ComponentReconciler.RegisterContractVersion can unmarshal a request body
+// into it and, for non-current versions, migrate it forward to *Reconciliation.
+type ReconciliationModel interface {
+	Validate() error
+}
+
+// Status reflects the high-level outcome of a reconciliation run, reported back to the
+// caller via a CallbackHandler.
+type Status string
+
+const (
+	NotStarted Status = "notstarted"
+	Running    Status = "running"
+	Success    Status = "success"
+	Error      Status = "error"
+	Failed     Status = "failed"
+)
+
+// Phase identifies which lifecycle flow a Reconciliation is driving over the
+// /v{version}/run endpoint. It selects which hook events runner.Run executes; the
+// non-hook resources are applied the same way for every Phase.
+type Phase string
+
+const (
+	PhaseInstall  Phase = "install"
+	PhaseUpgrade  Phase = "upgrade"
+	PhaseDelete   Phase = "delete"
+	PhaseRollback Phase = "rollback"
+)
+
+// Configuration is a single key/value override applied on top of the chart's default
+// values when rendering manifests for a component.
+type Configuration struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// Reconciliation is the payload a caller PUTs/POSTs to /v{version}/run to ask the
+// reconciler to bring one component into the desired state.
+type Reconciliation struct {
+	Component     string          `json:"component"`
+	Namespace     string          `json:"namespace"`
+	Version       string          `json:"version"`
+	Profile       string          `json:"profile"`
+	Phase         Phase           `json:"phase"`
+	Configuration []Configuration `json:"configuration"`
+	Kubeconfig    string          `json:"kubeconfig"`
+	CallbackURL   string          `json:"callbackURL"`
+	CorrelationID string          `json:"correlationID"`
+
+	// Timeout overrides the reconciler's default per-reconciliation lifetime
+	// (ComponentReconciler.reconciliationTimeout) for this request only. Zero means
+	// "use the default".
+	Timeout time.Duration `json:"timeout,omitempty"`
+
+	// CallbackFct is only set by StartLocal: it lets an in-process caller receive
+	// status updates without standing up an HTTP endpoint.
+	CallbackFct func(status Status) error `json:"-"`
+}
+
+// Validate makes *Reconciliation satisfy ReconciliationModel.
+func (m *Reconciliation) Validate() error {
+	if m.Component == "" {
+		return fmt.Errorf("field 'component' cannot be empty")
+	}
+	if m.Version == "" {
+		return fmt.Errorf("field 'version' cannot be empty")
+	}
+	return nil
+}
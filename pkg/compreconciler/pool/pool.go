@@ -0,0 +1,77 @@
+// Package pool provides a small bounded worker pool used to serialize reconciliation
+// goroutines, so a burst of incoming requests can't exhaust API-server rate limits or
+// memory.
+package pool
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrQueueFull is returned by Run when the pool's queue is already at capacity.
+// Callers typically translate this into an HTTP 429 with a Retry-After header.
+var ErrQueueFull = errors.New("worker pool queue is full")
+
+// Pool runs submitted tasks on a fixed number of goroutines, backed by a bounded queue.
+type Pool struct {
+	tasks chan func()
+	stop  chan struct{}
+}
+
+// New starts a Pool with size worker goroutines and a queue that can hold up to
+// queueDepth pending tasks beyond the ones already running.
+func New(size, queueDepth int) *Pool {
+	if size <= 0 {
+		size = 1
+	}
+	if queueDepth < 0 {
+		queueDepth = 0
+	}
+
+	p := &Pool{
+		tasks: make(chan func(), queueDepth),
+		stop:  make(chan struct{}),
+	}
+	for i := 0; i < size; i++ {
+		go p.worker()
+	}
+	return p
+}
+
+func (p *Pool) worker() {
+	for {
+		select {
+		case task, ok := <-p.tasks:
+			if !ok {
+				return
+			}
+			task()
+		case <-p.stop:
+			return
+		}
+	}
+}
+
+// Run enqueues task and blocks until it completes or ctx is done, whichever comes
+// first. It returns ErrQueueFull immediately, without enqueuing, if the queue is
+// already at capacity.
+func (p *Pool) Run(ctx context.Context, task func() error) error {
+	done := make(chan error, 1)
+	select {
+	case p.tasks <- func() { done <- task() }:
+	default:
+		return ErrQueueFull
+	}
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close stops all worker goroutines. Tasks already queued are not guaranteed to run.
+func (p *Pool) Close() {
+	close(p.stop)
+}
@@ -3,11 +3,14 @@ package compreconciler
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"time"
 
+	"github.com/kyma-incubator/reconciler/pkg/compreconciler/pool"
 	"github.com/kyma-incubator/reconciler/pkg/logger"
 
 	"go.uber.org/zap"
@@ -19,11 +22,15 @@ import (
 )
 
 const (
-	paramContractVersion  = "version"
-	defaultServerPort     = 8080
-	defaultMaxRetries     = 5
-	defaultUpdateInterval = 30 * time.Second
-	defaultRetryDelay     = 30 * time.Second
+	paramContractVersion         = "version"
+	defaultServerPort            = 8080
+	defaultMaxRetries            = 5
+	defaultUpdateInterval        = 30 * time.Second
+	defaultRetryDelay            = 30 * time.Second
+	defaultWorkerPoolSize        = 1
+	defaultWorkerPoolQueueDepth  = 10
+	defaultReconciliationTimeout = 30 * time.Minute
+	defaultContractVersion       = "1"
 )
 
 type Action interface {
@@ -42,6 +49,16 @@ type ComponentReconciler struct {
 	retryDelay        time.Duration
 	interval          time.Duration
 	timeout           time.Duration
+	minReadySeconds   time.Duration
+
+	workerPoolSize        int
+	workerPoolQueueDepth  int
+	reconciliationTimeout time.Duration
+	workerPool            *pool.Pool
+	registry              *jobRegistry
+
+	contractVersions       map[string]*contractVersion
+	currentContractVersion string
 }
 
 type serverOpts struct {
@@ -73,6 +90,24 @@ func (r *ComponentReconciler) validate() {
 	if r.retryDelay <= 0 {
 		r.retryDelay = defaultRetryDelay
 	}
+	if r.workerPoolSize <= 0 {
+		r.workerPoolSize = defaultWorkerPoolSize
+	}
+	if r.workerPoolQueueDepth <= 0 {
+		r.workerPoolQueueDepth = defaultWorkerPoolQueueDepth
+	}
+	if r.reconciliationTimeout <= 0 {
+		r.reconciliationTimeout = defaultReconciliationTimeout
+	}
+	if r.workerPool == nil {
+		r.workerPool = pool.New(r.workerPoolSize, r.workerPoolQueueDepth)
+	}
+	if r.registry == nil {
+		r.registry = newJobRegistry()
+	}
+	if len(r.contractVersions) == 0 {
+		r.RegisterContractVersion(defaultContractVersion, func() ReconciliationModel { return &Reconciliation{} }, nil)
+	}
 }
 
 func (r *ComponentReconciler) Configure(updateInterval time.Duration, maxRetries int, retryDelay time.Duration) *ComponentReconciler {
@@ -104,9 +139,33 @@ func (r *ComponentReconciler) WithPostInstallAction(postInstallAction Action) *C
 	return r
 }
 
-func (r *ComponentReconciler) WithProgressTrackerConfig(interval, timeout time.Duration) *ComponentReconciler {
+// WithProgressTrackerConfig configures how the progress tracker polls resources for
+// readiness. minReadySeconds, when non-zero, overrides each tracked workload's own
+// Spec.MinReadySeconds for availability checks (see progress.AvailableState); when
+// zero, each workload's own Spec.MinReadySeconds is used.
+func (r *ComponentReconciler) WithProgressTrackerConfig(interval, timeout, minReadySeconds time.Duration) *ComponentReconciler {
 	r.interval = interval
 	r.timeout = timeout
+	r.minReadySeconds = minReadySeconds
+	return r
+}
+
+// WithWorkerPool bounds how many reconciliations run concurrently: size goroutines
+// serve requests, and up to queueDepth more wait in the queue. A request that arrives
+// once the queue is also full gets HTTP 429 with a Retry-After header instead of
+// blocking indefinitely. A size or queueDepth of zero or less is replaced by
+// defaultWorkerPoolSize or defaultWorkerPoolQueueDepth.
+func (r *ComponentReconciler) WithWorkerPool(size, queueDepth int) *ComponentReconciler {
+	r.workerPoolSize = size
+	r.workerPoolQueueDepth = queueDepth
+	return r
+}
+
+// WithReconciliationTimeout bounds how long a single reconciliation may run before its
+// context is cancelled. A Reconciliation's own Timeout field, when set, overrides this
+// on a per-request basis.
+func (r *ComponentReconciler) WithReconciliationTimeout(timeout time.Duration) *ComponentReconciler {
+	r.reconciliationTimeout = timeout
 	return r
 }
 
@@ -135,16 +194,34 @@ func (r *ComponentReconciler) StartRemote(ctx context.Context) error {
 		fmt.Sprintf("/v{%s}/run", paramContractVersion),
 		func(w http.ResponseWriter, req *http.Request) {
 			model, err := r.model(req)
+			var unsupported *unsupportedVersionError
+			if errors.As(err, &unsupported) {
+				w.Header().Set("Accept-Version", strings.Join(unsupported.supported, ", "))
+				http.Error(w, err.Error(), http.StatusNotAcceptable)
+				return
+			}
 			if err != nil {
 				r.sendError(w, err)
+				return
 			}
+			w.Header().Set("Content-Version", r.currentContractVersion)
 
 			remoteCbh, err := newRemoteCallbackHandler(model.CallbackURL, r.debug)
 			if err != nil {
 				r.sendError(w, err)
+				return
 			}
 
-			if err := r.start(ctx, model, remoteCbh); err != nil {
+			//start() blocks until the reconciliation completes (or its context is
+			//cancelled), so req.Context() stays valid for the whole run: it's cancelled
+			//on client disconnect, which in turn cancels the reconciliation.
+			err = r.start(req.Context(), model, remoteCbh)
+			if errors.Is(err, pool.ErrQueueFull) {
+				w.Header().Set("Retry-After", fmt.Sprintf("%d", int(r.retryDelay.Seconds())))
+				http.Error(w, "reconciler is at capacity, please retry later", http.StatusTooManyRequests)
+				return
+			}
+			if err != nil {
 				r.sendError(w, err)
 			}
 		}).
@@ -160,10 +237,29 @@ func (r *ComponentReconciler) StartRemote(ctx context.Context) error {
 	return srv.Start(ctx) //blocking until ctx gets closed
 }
 
+// start enforces a per-reconciliation context lifetime (r.reconciliationTimeout,
+// or model.Timeout if set) and runs the reconciliation on r.workerPool so a burst of
+// requests can't spawn unbounded goroutines. Duplicate requests for a CorrelationID
+// already in flight are coalesced: the caller gets the running job's current status
+// instead of a second reconciliation being started.
 func (r *ComponentReconciler) start(ctx context.Context, model *Reconciliation, cbh CallbackHandler) error {
-	//TODO: run in context with max 30min lifetime
-	//TODO: assign to worker pool
-	return (&runner{r}).Run(ctx, model, cbh)
+	j, isNew := r.registry.register(model.CorrelationID)
+	if !isNew {
+		return cbh.Callback(j.getStatus(), nil)
+	}
+	defer r.registry.release(model.CorrelationID)
+
+	timeout := r.reconciliationTimeout
+	if model.Timeout > 0 {
+		timeout = model.Timeout
+	}
+	runCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	trackingCbh := &trackingCallbackHandler{delegate: cbh, job: j}
+	return r.workerPool.Run(runCtx, func() error {
+		return (&runner{r}).Run(runCtx, model, trackingCbh)
+	})
 }
 
 func (r *ComponentReconciler) sendError(w http.ResponseWriter, err error) {
@@ -177,27 +273,16 @@ func (r *ComponentReconciler) model(req *http.Request) (*Reconciliation, error)
 	if err != nil {
 		return nil, err
 	}
-
-	b, err := ioutil.ReadAll(req.Body)
-	if err != nil {
-		return nil, err
+	if contractVersion == "" {
+		return nil, fmt.Errorf("contract version cannot be empty")
 	}
 
-	model, err := r.modelForVersion(contractVersion)
-	if err != nil {
-		return nil, err
-	}
-	err = json.Unmarshal(b, model)
+	b, err := ioutil.ReadAll(req.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	return model, err
-}
-
-func (r *ComponentReconciler) modelForVersion(contractVersion string) (*Reconciliation, error) {
-	if contractVersion == "" {
-		return nil, fmt.Errorf("contract version cannot be empty")
-	}
-	return &Reconciliation{}, nil //change this function if different contract versions have to be supported
+	return r.resolveReconciliation(contractVersion, func(payload interface{}) error {
+		return json.Unmarshal(b, payload)
+	})
 }
\ No newline at end of file
@@ -0,0 +1,75 @@
+package compreconciler
+
+import "sync"
+
+// job tracks an in-flight reconciliation so duplicate PUT/POSTs for the same
+// CorrelationID can be coalesced instead of starting a competing goroutine.
+type job struct {
+	mu     sync.Mutex
+	status Status
+}
+
+func (j *job) setStatus(status Status) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.status = status
+}
+
+func (j *job) getStatus() Status {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.status
+}
+
+// jobRegistry is an in-memory map of CorrelationID to in-flight job, used to coalesce
+// duplicate requests for the same component.
+type jobRegistry struct {
+	mu   sync.Mutex
+	jobs map[string]*job
+}
+
+func newJobRegistry() *jobRegistry {
+	return &jobRegistry{jobs: map[string]*job{}}
+}
+
+// register returns the job already running for correlationID and false if one exists,
+// so the caller can skip starting a duplicate reconciliation. Otherwise it registers
+// and returns a new job and true. An empty correlationID is never coalesced: callers
+// that don't set it would otherwise collide with every other such caller and have
+// unrelated reconciliations dropped.
+func (jr *jobRegistry) register(correlationID string) (*job, bool) {
+	if correlationID == "" {
+		return &job{status: NotStarted}, true
+	}
+
+	jr.mu.Lock()
+	defer jr.mu.Unlock()
+	if existing, ok := jr.jobs[correlationID]; ok {
+		return existing, false
+	}
+	j := &job{status: NotStarted}
+	jr.jobs[correlationID] = j
+	return j, true
+}
+
+func (jr *jobRegistry) release(correlationID string) {
+	if correlationID == "" {
+		return
+	}
+
+	jr.mu.Lock()
+	defer jr.mu.Unlock()
+	delete(jr.jobs, correlationID)
+}
+
+// trackingCallbackHandler records every status update on job in addition to forwarding
+// it to delegate, so jobRegistry can answer status queries for coalesced requests.
+type trackingCallbackHandler struct {
+	delegate CallbackHandler
+	job      *job
+}
+
+func (h *trackingCallbackHandler) Callback(status Status, reconcileErr error) error {
+	h.job.setStatus(status)
+	return h.delegate.Callback(status, reconcileErr)
+}
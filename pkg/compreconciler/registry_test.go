@@ -0,0 +1,44 @@
+package compreconciler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobRegistryRegister(t *testing.T) {
+	t.Run("a second register for the same CorrelationID is coalesced", func(t *testing.T) {
+		jr := newJobRegistry()
+		first, isNew := jr.register("abc")
+		require.True(t, isNew)
+
+		second, isNew := jr.register("abc")
+		require.False(t, isNew)
+		require.Same(t, first, second)
+	})
+
+	t.Run("an empty CorrelationID is never coalesced", func(t *testing.T) {
+		jr := newJobRegistry()
+		first, isNew := jr.register("")
+		require.True(t, isNew)
+
+		second, isNew := jr.register("")
+		require.True(t, isNew)
+		require.NotSame(t, first, second)
+	})
+
+	t.Run("release frees the slot for a later register", func(t *testing.T) {
+		jr := newJobRegistry()
+		_, _ = jr.register("abc")
+		jr.release("abc")
+
+		_, isNew := jr.register("abc")
+		require.True(t, isNew)
+	})
+}
+
+func TestJobStatus(t *testing.T) {
+	j := &job{status: NotStarted}
+	j.setStatus(Running)
+	require.Equal(t, Running, j.getStatus())
+}
@@ -0,0 +1,192 @@
+package compreconciler
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/kyma-incubator/reconciler/pkg/reconciler/kubernetes/progress"
+)
+
+type runner struct {
+	*ComponentReconciler
+}
+
+// Run drives a single Reconciliation through its pre-install hooks, preInstallAction,
+// installAction, post-install hooks and postInstallAction, reporting the outcome via
+// cbh. Which hook events bracket the install action is determined by model.Phase; the
+// rest of the flow is the same for every Phase, so for delete and rollback requests the
+// non-hook resources are still created or updated, not deleted or rolled back.
+func (r *runner) Run(ctx context.Context, model *Reconciliation, cbh CallbackHandler) error {
+	if err := cbh.Callback(Running, nil); err != nil {
+		return err
+	}
+
+	kubeClient, err := newKubeClientSet(model.Kubeconfig)
+	if err != nil {
+		return r.fail(cbh, err)
+	}
+	dynamicClient, err := newDynamicClientSet(model.Kubeconfig)
+	if err != nil {
+		return r.fail(cbh, err)
+	}
+
+	manifest, err := r.chartProvider.RenderManifest(model.Component, model.Namespace, model.Version, model.Profile, model.Configuration)
+	if err != nil {
+		return r.fail(cbh, err)
+	}
+	resources, err := parseManifest(manifest)
+	if err != nil {
+		return r.fail(cbh, err)
+	}
+
+	hooks := NewHookExecutor(kubeClient, r.interval, r.timeout)
+	preEvent, postEvent := hookEventsForPhase(model.Phase)
+
+	if err := r.retry(func() error {
+		if r.preInstallAction != nil {
+			if err := r.preInstallAction.Run(model.Version, kubeClient); err != nil {
+				return err
+			}
+		}
+		return hooks.Execute(ctx, resources, preEvent)
+	}); err != nil {
+		return r.fail(cbh, err)
+	}
+
+	for _, resource := range nonHookResources(resources) {
+		if err := applyResource(ctx, dynamicClient, resource, r.maxRetries, r.retryDelay); err != nil {
+			return r.fail(cbh, err)
+		}
+	}
+	if r.installAction != nil {
+		if err := r.retry(func() error {
+			return r.installAction.Run(model.Version, kubeClient)
+		}); err != nil {
+			return r.fail(cbh, err)
+		}
+	}
+
+	if r.postInstallAction != nil {
+		if err := r.waitAvailable(ctx, kubeClient, resources); err != nil {
+			return r.fail(cbh, err)
+		}
+	}
+
+	if err := r.retry(func() error {
+		if err := hooks.Execute(ctx, resources, postEvent); err != nil {
+			return err
+		}
+		if r.postInstallAction != nil {
+			return r.postInstallAction.Run(model.Version, kubeClient)
+		}
+		return nil
+	}); err != nil {
+		return r.fail(cbh, err)
+	}
+
+	return cbh.Callback(Success, nil)
+}
+
+func (r *runner) fail(cbh CallbackHandler, err error) error {
+	_ = cbh.Callback(Error, err)
+	return err
+}
+
+// retry re-runs fn up to r.maxRetries times, waiting r.retryDelay between attempts,
+// returning the last error if every attempt fails.
+func (r *runner) retry(fn func() error) error {
+	var err error
+	for attempt := 0; attempt <= r.maxRetries; attempt++ {
+		if err = fn(); err == nil {
+			return nil
+		}
+		if attempt == r.maxRetries {
+			break
+		}
+		time.Sleep(r.retryDelay)
+	}
+	return err
+}
+
+// waitAvailable blocks until every tracked workload among resources has held Ready
+// continuously for r.minReadySeconds (or its own Spec.MinReadySeconds, when
+// r.minReadySeconds is zero), so postInstallAction doesn't fire against a fleet that
+// merely flapped ready for a single poll interval. Resources of a kind this package
+// doesn't track for availability (ConfigMaps, Services, CRDs, ...) are skipped.
+func (r *runner) waitAvailable(ctx context.Context, kubeClient kubernetes.Interface, resources []*unstructured.Unstructured) error {
+	tracker, err := progress.NewProgressTracker(kubeClient, r.interval, r.timeout)
+	if err != nil {
+		return err
+	}
+	if r.minReadySeconds > 0 {
+		tracker.WithMinReadySecondsOverride(r.minReadySeconds)
+	}
+
+	var tracked bool
+	for _, resource := range nonHookResources(resources) {
+		kind, ok := availabilityTrackedKind(resource.GetKind())
+		if !ok {
+			continue
+		}
+		if err := tracker.AddResource(kind, resource.GetNamespace(), resource.GetName(), progress.AvailableState); err != nil {
+			return err
+		}
+		tracked = true
+	}
+	if !tracked {
+		return nil
+	}
+	return tracker.Watch(ctx)
+}
+
+// availabilityTrackedKind maps a manifest's Kind to the progress.WatchableResource
+// constant it's tracked as, for the kinds whose rollout can flap ready before settling
+// (i.e. the ones MinReadySeconds-style availability checks apply to).
+func availabilityTrackedKind(kind string) (progress.WatchableResource, bool) {
+	switch kind {
+	case "Deployment":
+		return progress.Deployment, true
+	case "StatefulSet":
+		return progress.StatefulSet, true
+	case "DaemonSet":
+		return progress.DaemonSet, true
+	case "ReplicaSet":
+		return progress.ReplicaSet, true
+	default:
+		return "", false
+	}
+}
+
+// nonHookResources returns the manifests that aren't annotated with helm.sh/hook, i.e.
+// the ones applied directly as part of the install/upgrade step rather than through the
+// HookExecutor.
+func nonHookResources(manifests []*unstructured.Unstructured) []*unstructured.Unstructured {
+	var plain []*unstructured.Unstructured
+	for _, manifest := range manifests {
+		if _, isHook := manifest.GetAnnotations()[annotationHook]; !isHook {
+			plain = append(plain, manifest)
+		}
+	}
+	return plain
+}
+
+func newKubeClientSet(kubeconfig string) (kubernetes.Interface, error) {
+	restCfg, err := clientcmd.RESTConfigFromKubeConfig([]byte(kubeconfig))
+	if err != nil {
+		return nil, err
+	}
+	return kubernetes.NewForConfig(restCfg)
+}
+
+func newDynamicClientSet(kubeconfig string) (dynamic.Interface, error) {
+	restCfg, err := clientcmd.RESTConfigFromKubeConfig([]byte(kubeconfig))
+	if err != nil {
+		return nil, err
+	}
+	return dynamic.NewForConfig(restCfg)
+}
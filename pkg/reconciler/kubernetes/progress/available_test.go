@@ -0,0 +1,58 @@
+package progress
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/stretchr/testify/require"
+)
+
+// readyPod returns a pod whose Status.Conditions are ordered the way kubelet actually
+// reports them - PodReady is not the last entry - with readyTransition as the moment its
+// Ready condition flipped to True. This ordering is what catches a naive "capture the
+// range variable's address" bug in isAvailable: a fix that works only because PodReady
+// happens to be last would pass a conditions slice built in declaration order but fail
+// against this one.
+func readyPod(name, namespace string, labels map[string]string, readyTransition time.Time) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{
+				{Type: corev1.PodInitialized, Status: corev1.ConditionTrue, LastTransitionTime: metav1.NewTime(readyTransition.Add(-time.Minute))},
+				{Type: corev1.PodReady, Status: corev1.ConditionTrue, LastTransitionTime: metav1.NewTime(readyTransition)},
+				{Type: corev1.ContainersReady, Status: corev1.ConditionTrue, LastTransitionTime: metav1.NewTime(readyTransition)},
+				{Type: corev1.PodScheduled, Status: corev1.ConditionTrue, LastTransitionTime: metav1.NewTime(readyTransition.Add(-2 * time.Hour))},
+			},
+		},
+	}
+}
+
+func TestIsAvailable(t *testing.T) {
+	labels := map[string]string{"app": "my-app"}
+
+	t.Run("not available until minReadySeconds have passed since the pod's own Ready transition", func(t *testing.T) {
+		client := fake.NewSimpleClientset(readyPod("pod-1", "default", labels, time.Now()))
+		available, err := isAvailable(context.Background(), client, "default", "app=my-app", time.Hour)
+		require.NoError(t, err)
+		require.False(t, available)
+	})
+
+	t.Run("available once the pod's Ready transition is older than minReadySeconds", func(t *testing.T) {
+		client := fake.NewSimpleClientset(readyPod("pod-1", "default", labels, time.Now().Add(-time.Hour)))
+		available, err := isAvailable(context.Background(), client, "default", "app=my-app", time.Minute)
+		require.NoError(t, err)
+		require.True(t, available)
+	})
+
+	t.Run("no matching pods is not available", func(t *testing.T) {
+		client := fake.NewSimpleClientset()
+		available, err := isAvailable(context.Background(), client, "default", "app=my-app", time.Minute)
+		require.NoError(t, err)
+		require.False(t, available)
+	})
+}
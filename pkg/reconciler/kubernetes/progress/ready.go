@@ -4,14 +4,18 @@ import (
 	"context"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	appsclient "k8s.io/client-go/kubernetes/typed/apps/v1"
 	"sort"
+	"time"
 )
 
 const expectedReadyReplicas = 1
@@ -22,14 +26,28 @@ func isDeploymentReady(ctx context.Context, client kubernetes.Interface, object
 	if err != nil {
 		return false, err
 	}
+	if deployment.Status.ObservedGeneration < deployment.Generation {
+		return false, nil
+	}
 
 	replicaSet, err := getLatestReplicaSet(ctx, deployment, client.AppsV1())
 	if err != nil || replicaSet == nil {
 		return false, err
 	}
 
-	isReady := replicaSet.Status.ReadyReplicas >= expectedReadyReplicas
-	return isReady, nil
+	if replicaSet.Status.ReadyReplicas < expectedReadyReplicas {
+		return false, nil
+	}
+	if object.state != AvailableState {
+		return true, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return false, err
+	}
+	minReadySeconds := resolveMinReadySeconds(object, time.Duration(deployment.Spec.MinReadySeconds)*time.Second)
+	return isAvailable(ctx, client, object.namespace, selector.String(), minReadySeconds)
 }
 
 func isStatefulSetReady(ctx context.Context, client kubernetes.Interface, object *trackerResource) (bool, error) {
@@ -37,6 +55,9 @@ func isStatefulSetReady(ctx context.Context, client kubernetes.Interface, object
 	if err != nil {
 		return false, err
 	}
+	if statefulSet.Status.ObservedGeneration < statefulSet.Generation {
+		return false, nil
+	}
 
 	var partition, replicas = 0, 1
 	if statefulSet.Spec.UpdateStrategy.RollingUpdate != nil && statefulSet.Spec.UpdateStrategy.RollingUpdate.Partition != nil {
@@ -52,8 +73,48 @@ func isStatefulSetReady(ctx context.Context, client kubernetes.Interface, object
 		return false, nil
 	}
 
-	isReady := int(statefulSet.Status.ReadyReplicas) == replicas
-	return isReady, nil
+	if int(statefulSet.Status.ReadyReplicas) != replicas {
+		return false, nil
+	}
+	if object.state != AvailableState {
+		return true, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(statefulSet.Spec.Selector)
+	if err != nil {
+		return false, err
+	}
+	minReadySeconds := resolveMinReadySeconds(object, time.Duration(statefulSet.Spec.MinReadySeconds)*time.Second)
+	return isAvailable(ctx, client, object.namespace, selector.String(), minReadySeconds)
+}
+
+func isReplicaSetReady(ctx context.Context, client kubernetes.Interface, object *trackerResource) (bool, error) {
+	replicaSet, err := client.AppsV1().ReplicaSets(object.namespace).Get(ctx, object.name, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	if replicaSet.Status.ObservedGeneration < replicaSet.Generation {
+		return false, nil
+	}
+
+	var expectedReplicas int32 = 1
+	if replicaSet.Spec.Replicas != nil {
+		expectedReplicas = *replicaSet.Spec.Replicas
+	}
+
+	if replicaSet.Status.ReadyReplicas != expectedReplicas {
+		return false, nil
+	}
+	if object.state != AvailableState {
+		return true, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(replicaSet.Spec.Selector)
+	if err != nil {
+		return false, err
+	}
+	minReadySeconds := resolveMinReadySeconds(object, time.Duration(replicaSet.Spec.MinReadySeconds)*time.Second)
+	return isAvailable(ctx, client, object.namespace, selector.String(), minReadySeconds)
 }
 
 func isPodReady(ctx context.Context, client kubernetes.Interface, object *trackerResource) (bool, error) {
@@ -79,13 +140,66 @@ func isDaemonSetReady(ctx context.Context, client kubernetes.Interface, object *
 	if err != nil {
 		return false, err
 	}
+	if daemonSet.Status.ObservedGeneration < daemonSet.Generation {
+		return false, nil
+	}
 
 	if daemonSet.Status.UpdatedNumberScheduled != daemonSet.Status.DesiredNumberScheduled {
 		return false, nil
 	}
 
-	isReady := int(daemonSet.Status.NumberReady) >= expectedReadyDaemonSet
-	return isReady, nil
+	if int(daemonSet.Status.NumberReady) < expectedReadyDaemonSet {
+		return false, nil
+	}
+	if object.state != AvailableState {
+		return true, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(daemonSet.Spec.Selector)
+	if err != nil {
+		return false, err
+	}
+	minReadySeconds := resolveMinReadySeconds(object, time.Duration(daemonSet.Spec.MinReadySeconds)*time.Second)
+	return isAvailable(ctx, client, object.namespace, selector.String(), minReadySeconds)
+}
+
+// resolveMinReadySeconds returns the caller-supplied override for an AvailableState
+// check, falling back to the workload's own Spec.MinReadySeconds when none was set.
+func resolveMinReadySeconds(object *trackerResource, specMinReadySeconds time.Duration) time.Duration {
+	if object.minReadySecondsOverride != nil {
+		return *object.minReadySecondsOverride
+	}
+	return specMinReadySeconds
+}
+
+// isAvailable requires every pod matching selector to have held the Ready condition
+// continuously for at least minReadySeconds, mirroring the "InstanceAvailable" pattern
+// workloads controllers use to gate rollouts on a stability window.
+func isAvailable(ctx context.Context, client kubernetes.Interface, namespace, selector string, minReadySeconds time.Duration) (bool, error) {
+	pods, err := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return false, err
+	}
+	if len(pods.Items) == 0 {
+		return false, nil
+	}
+
+	for i := range pods.Items {
+		var readySince *metav1.Time
+		for _, condition := range pods.Items[i].Status.Conditions {
+			if condition.Type == corev1.PodReady && condition.Status == corev1.ConditionTrue {
+				transitionTime := condition.LastTransitionTime
+				readySince = &transitionTime
+			}
+		}
+		if readySince == nil {
+			return false, nil
+		}
+		if time.Since(readySince.Time) < minReadySeconds {
+			return false, nil
+		}
+	}
+	return true, nil
 }
 
 func isJobReady(ctx context.Context, client kubernetes.Interface, object *trackerResource) (bool, error) {
@@ -102,7 +216,133 @@ func isJobReady(ctx context.Context, client kubernetes.Interface, object *tracke
 	return true, err
 }
 
-func isCRDBetaReady(ctx context.Context, object *trackerResource) (bool, error) {
+// isServiceReady mirrors Helm's own resource-ready check: ClusterIP/NodePort services are
+// ready once they have at least one endpoint address; LoadBalancer services additionally
+// need Status.LoadBalancer.Ingress to be populated. Services without a selector (e.g.
+// backed by a manually managed Endpoints object) and ExternalName services have no
+// endpoints to wait for, so they're considered ready as soon as they exist.
+func isServiceReady(ctx context.Context, client kubernetes.Interface, object *trackerResource) (bool, error) {
+	service, err := client.CoreV1().Services(object.namespace).Get(ctx, object.name, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	if service.Spec.Type == corev1.ServiceTypeExternalName || service.Spec.Selector == nil {
+		return true, nil
+	}
+
+	hasAddresses, err := serviceHasEndpointAddresses(ctx, client, object.namespace, object.name)
+	if err != nil {
+		return false, err
+	}
+	if !hasAddresses {
+		return false, nil
+	}
+
+	if service.Spec.Type == corev1.ServiceTypeLoadBalancer {
+		return len(service.Status.LoadBalancer.Ingress) > 0, nil
+	}
+
+	return true, nil
+}
+
+// serviceHasEndpointAddresses reports whether name has at least one ready endpoint
+// address. It prefers the legacy Endpoints object, but some clusters/CNIs stop
+// populating Endpoints for a service, so it falls back to listing the service's
+// EndpointSlices when Endpoints has none.
+func serviceHasEndpointAddresses(ctx context.Context, client kubernetes.Interface, namespace, name string) (bool, error) {
+	endpoints, err := client.CoreV1().Endpoints(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return false, err
+	}
+	if err == nil {
+		for _, subset := range endpoints.Subsets {
+			if len(subset.Addresses) > 0 {
+				return true, nil
+			}
+		}
+	}
+
+	slices, err := client.DiscoveryV1().EndpointSlices(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: discoveryv1.LabelServiceName + "=" + name,
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, slice := range slices.Items {
+		for _, endpoint := range slice.Endpoints {
+			if len(endpoint.Addresses) > 0 && (endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// isPVCReady requires the claim to have been bound to a volume.
+func isPVCReady(ctx context.Context, client kubernetes.Interface, object *trackerResource) (bool, error) {
+	pvc, err := client.CoreV1().PersistentVolumeClaims(object.namespace).Get(ctx, object.name, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	return pvc.Status.Phase == corev1.ClaimBound, nil
+}
+
+// isIngressReady requires the load balancer to have published at least one address.
+func isIngressReady(ctx context.Context, client kubernetes.Interface, object *trackerResource) (bool, error) {
+	ingress, err := client.NetworkingV1().Ingresses(object.namespace).Get(ctx, object.name, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	return len(ingress.Status.LoadBalancer.Ingress) > 0, nil
+}
+
+// isConditionReady is the fallback readiness check for kinds without a dedicated
+// readyCheckFunc: it treats a Ready or Available condition with status=True, reported
+// against the object's current generation, as ready. This covers CRD-defined resources
+// shipped by a chart that follow the common controller status-conditions convention.
+func isConditionReady(ctx context.Context, client kubernetes.Interface, object *trackerResource) (bool, error) {
+	if err := object.info.Get(); err != nil {
+		return false, err
+	}
+	u, ok := object.info.Object.(*unstructured.Unstructured)
+	if !ok {
+		return false, nil
+	}
+
+	generation, _, err := unstructured.NestedInt64(u.Object, "metadata", "generation")
+	if err != nil {
+		return false, err
+	}
+
+	conditions, found, err := unstructured.NestedSlice(u.Object, "status", "conditions")
+	if err != nil || !found {
+		return false, err
+	}
+
+	for _, rawCondition := range conditions {
+		condition, ok := rawCondition.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		condType, _, _ := unstructured.NestedString(condition, "type")
+		if condType != "Ready" && condType != "Available" {
+			continue
+		}
+		status, _, _ := unstructured.NestedString(condition, "status")
+		if status != string(corev1.ConditionTrue) {
+			continue
+		}
+		observedGeneration, found, _ := unstructured.NestedInt64(condition, "observedGeneration")
+		if found && observedGeneration < generation {
+			continue
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+func isCRDBetaReady(ctx context.Context, client kubernetes.Interface, object *trackerResource) (bool, error) {
 	if err := object.info.Get(); err != nil {
 		return false, err
 	}
@@ -116,7 +356,7 @@ func isCRDBetaReady(ctx context.Context, object *trackerResource) (bool, error)
 	return true, nil
 }
 
-func isCRDReady(ctx context.Context, object *trackerResource) (bool, error) {
+func isCRDReady(ctx context.Context, client kubernetes.Interface, object *trackerResource) (bool, error) {
 	if err := object.info.Get(); err != nil {
 		return false, err
 	}
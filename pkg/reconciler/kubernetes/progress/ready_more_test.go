@@ -0,0 +1,155 @@
+package progress
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsServiceReady(t *testing.T) {
+	t.Run("ExternalName services are always ready", func(t *testing.T) {
+		svc := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "default"},
+			Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeExternalName},
+		}
+		client := fake.NewSimpleClientset(svc)
+		ready, err := isServiceReady(context.Background(), client, &trackerResource{namespace: "default", name: "svc"})
+		require.NoError(t, err)
+		require.True(t, ready)
+	})
+
+	t.Run("ClusterIP service becomes ready once Endpoints has an address", func(t *testing.T) {
+		svc := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "default"},
+			Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP, Selector: map[string]string{"app": "my-app"}},
+		}
+		endpoints := &corev1.Endpoints{
+			ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "default"},
+			Subsets:    []corev1.EndpointSubset{{Addresses: []corev1.EndpointAddress{{IP: "10.0.0.1"}}}},
+		}
+		client := fake.NewSimpleClientset(svc, endpoints)
+		ready, err := isServiceReady(context.Background(), client, &trackerResource{namespace: "default", name: "svc"})
+		require.NoError(t, err)
+		require.True(t, ready)
+	})
+
+	t.Run("falls back to EndpointSlice when Endpoints has no addresses", func(t *testing.T) {
+		svc := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "default"},
+			Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP, Selector: map[string]string{"app": "my-app"}},
+		}
+		endpoints := &corev1.Endpoints{ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "default"}}
+		ready := true
+		slice := &discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "svc-abcde",
+				Namespace: "default",
+				Labels:    map[string]string{discoveryv1.LabelServiceName: "svc"},
+			},
+			Endpoints: []discoveryv1.Endpoint{
+				{Addresses: []string{"10.0.0.1"}, Conditions: discoveryv1.EndpointConditions{Ready: &ready}},
+			},
+		}
+		client := fake.NewSimpleClientset(svc, endpoints, slice)
+		readyResult, err := isServiceReady(context.Background(), client, &trackerResource{namespace: "default", name: "svc"})
+		require.NoError(t, err)
+		require.True(t, readyResult)
+	})
+
+	t.Run("LoadBalancer service additionally needs Status.LoadBalancer.Ingress", func(t *testing.T) {
+		svc := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "default"},
+			Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer, Selector: map[string]string{"app": "my-app"}},
+		}
+		endpoints := &corev1.Endpoints{
+			ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "default"},
+			Subsets:    []corev1.EndpointSubset{{Addresses: []corev1.EndpointAddress{{IP: "10.0.0.1"}}}},
+		}
+		client := fake.NewSimpleClientset(svc, endpoints)
+		ready, err := isServiceReady(context.Background(), client, &trackerResource{namespace: "default", name: "svc"})
+		require.NoError(t, err)
+		require.False(t, ready)
+	})
+}
+
+func TestIsPVCReady(t *testing.T) {
+	t.Run("bound claim is ready", func(t *testing.T) {
+		pvc := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-pvc", Namespace: "default"},
+			Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimBound},
+		}
+		client := fake.NewSimpleClientset(pvc)
+		ready, err := isPVCReady(context.Background(), client, &trackerResource{namespace: "default", name: "my-pvc"})
+		require.NoError(t, err)
+		require.True(t, ready)
+	})
+
+	t.Run("pending claim is not ready", func(t *testing.T) {
+		pvc := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-pvc", Namespace: "default"},
+			Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimPending},
+		}
+		client := fake.NewSimpleClientset(pvc)
+		ready, err := isPVCReady(context.Background(), client, &trackerResource{namespace: "default", name: "my-pvc"})
+		require.NoError(t, err)
+		require.False(t, ready)
+	})
+}
+
+func TestIsIngressReady(t *testing.T) {
+	t.Run("no published address is not ready", func(t *testing.T) {
+		ingress := &networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Name: "my-ingress", Namespace: "default"}}
+		client := fake.NewSimpleClientset(ingress)
+		ready, err := isIngressReady(context.Background(), client, &trackerResource{namespace: "default", name: "my-ingress"})
+		require.NoError(t, err)
+		require.False(t, ready)
+	})
+
+	t.Run("a published load balancer address is ready", func(t *testing.T) {
+		ingress := &networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-ingress", Namespace: "default"},
+			Status: networkingv1.IngressStatus{LoadBalancer: networkingv1.IngressLoadBalancerStatus{
+				Ingress: []networkingv1.IngressLoadBalancerIngress{{IP: "1.2.3.4"}},
+			}},
+		}
+		client := fake.NewSimpleClientset(ingress)
+		ready, err := isIngressReady(context.Background(), client, &trackerResource{namespace: "default", name: "my-ingress"})
+		require.NoError(t, err)
+		require.True(t, ready)
+	})
+}
+
+func TestIsReplicaSetReady(t *testing.T) {
+	replicas := int32(2)
+	t.Run("ReadyState is satisfied once ReadyReplicas matches Spec.Replicas", func(t *testing.T) {
+		rs := &appsv1.ReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-rs", Namespace: "default"},
+			Spec:       appsv1.ReplicaSetSpec{Replicas: &replicas},
+			Status:     appsv1.ReplicaSetStatus{ReadyReplicas: replicas, ObservedGeneration: 1},
+		}
+		client := fake.NewSimpleClientset(rs)
+		ready, err := isReplicaSetReady(context.Background(), client, &trackerResource{namespace: "default", name: "my-rs", state: ReadyState})
+		require.NoError(t, err)
+		require.True(t, ready)
+	})
+
+	t.Run("not ready while the status hasn't caught up with the current generation", func(t *testing.T) {
+		rs := &appsv1.ReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-rs", Namespace: "default", Generation: 2},
+			Spec:       appsv1.ReplicaSetSpec{Replicas: &replicas},
+			Status:     appsv1.ReplicaSetStatus{ReadyReplicas: replicas, ObservedGeneration: 1},
+		}
+		client := fake.NewSimpleClientset(rs)
+		ready, err := isReplicaSetReady(context.Background(), client, &trackerResource{namespace: "default", name: "my-rs", state: ReadyState})
+		require.NoError(t, err)
+		require.False(t, ready)
+	})
+}
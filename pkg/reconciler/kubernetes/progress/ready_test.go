@@ -0,0 +1,64 @@
+package progress
+
+import (
+	"testing"
+	"time"
+
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCrdReady(t *testing.T) {
+	t.Run("Established=True is ready", func(t *testing.T) {
+		crd := apiextv1.CustomResourceDefinition{Status: apiextv1.CustomResourceDefinitionStatus{
+			Conditions: []apiextv1.CustomResourceDefinitionCondition{
+				{Type: apiextv1.Established, Status: apiextv1.ConditionTrue},
+			},
+		}}
+		require.True(t, crdReady(crd))
+	})
+
+	t.Run("NamesAccepted=False is still treated as ready", func(t *testing.T) {
+		crd := apiextv1.CustomResourceDefinition{Status: apiextv1.CustomResourceDefinitionStatus{
+			Conditions: []apiextv1.CustomResourceDefinitionCondition{
+				{Type: apiextv1.NamesAccepted, Status: apiextv1.ConditionFalse},
+			},
+		}}
+		require.True(t, crdReady(crd))
+	})
+
+	t.Run("no matching condition is not ready", func(t *testing.T) {
+		crd := apiextv1.CustomResourceDefinition{}
+		require.False(t, crdReady(crd))
+	})
+}
+
+func TestCrdBetaReady(t *testing.T) {
+	t.Run("Established=True is ready", func(t *testing.T) {
+		crd := apiextv1beta1.CustomResourceDefinition{Status: apiextv1beta1.CustomResourceDefinitionStatus{
+			Conditions: []apiextv1beta1.CustomResourceDefinitionCondition{
+				{Type: apiextv1beta1.Established, Status: apiextv1beta1.ConditionTrue},
+			},
+		}}
+		require.True(t, crdBetaReady(crd))
+	})
+
+	t.Run("no matching condition is not ready", func(t *testing.T) {
+		crd := apiextv1beta1.CustomResourceDefinition{}
+		require.False(t, crdBetaReady(crd))
+	})
+}
+
+func TestResolveMinReadySeconds(t *testing.T) {
+	t.Run("uses the caller override when set", func(t *testing.T) {
+		override := 30 * time.Second
+		object := &trackerResource{minReadySecondsOverride: &override}
+		require.Equal(t, override, resolveMinReadySeconds(object, 5*time.Second))
+	})
+
+	t.Run("falls back to the workload's own Spec.MinReadySeconds", func(t *testing.T) {
+		object := &trackerResource{}
+		require.Equal(t, 5*time.Second, resolveMinReadySeconds(object, 5*time.Second))
+	})
+}
@@ -0,0 +1,206 @@
+package progress
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/resource"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ErrNoReadyCheck is returned (wrapped) when a resource's GroupVersionKind has no
+// registered readyCheckFunc and no resource.Info is available to fall back to
+// isConditionReady.
+var ErrNoReadyCheck = errors.New("no readiness check available for this resource")
+
+// WatchableResource identifies a Kubernetes kind the Tracker knows how to wait on.
+type WatchableResource string
+
+const (
+	Pod         WatchableResource = "pod"
+	Deployment  WatchableResource = "deployment"
+	DaemonSet   WatchableResource = "daemonset"
+	StatefulSet WatchableResource = "statefulset"
+	ReplicaSet  WatchableResource = "replicaset"
+	Job         WatchableResource = "job"
+	Service     WatchableResource = "service"
+	Ingress     WatchableResource = "ingress"
+	PVC         WatchableResource = "pvc"
+	CRD         WatchableResource = "crd"
+)
+
+// gvkByKind maps the WatchableResource shorthand accepted by AddResource to the
+// GroupVersionKind readyChecks are keyed by, so callers don't have to spell out a GVK
+// for the handful of kinds this package has built-in support for.
+var gvkByKind = map[WatchableResource]schema.GroupVersionKind{
+	Pod:         {Version: "v1", Kind: "Pod"},
+	Deployment:  {Group: "apps", Version: "v1", Kind: "Deployment"},
+	DaemonSet:   {Group: "apps", Version: "v1", Kind: "DaemonSet"},
+	StatefulSet: {Group: "apps", Version: "v1", Kind: "StatefulSet"},
+	ReplicaSet:  {Group: "apps", Version: "v1", Kind: "ReplicaSet"},
+	Job:         {Group: "batch", Version: "v1", Kind: "Job"},
+	Service:     {Version: "v1", Kind: "Service"},
+	Ingress:     {Group: "networking.k8s.io", Version: "v1", Kind: "Ingress"},
+	PVC:         {Version: "v1", Kind: "PersistentVolumeClaim"},
+	CRD:         {Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"},
+}
+
+// readyCheckFunc evaluates whether a tracked resource has reached the ready state.
+type readyCheckFunc func(ctx context.Context, client kubernetes.Interface, object *trackerResource) (bool, error)
+
+// readyChecks dispatches by GroupVersionKind instead of a hard-coded type switch, so
+// readiness checks for further kinds (e.g. CRD-defined resources shipped by a chart)
+// can be added via RegisterReadyCheck. A readyCheckFunc takes the unexported
+// *trackerResource, so such a check can only be written inside this package.
+var readyChecks = map[schema.GroupVersionKind]readyCheckFunc{
+	gvkByKind[Deployment]:  isDeploymentReady,
+	gvkByKind[StatefulSet]: isStatefulSetReady,
+	gvkByKind[DaemonSet]:   isDaemonSetReady,
+	gvkByKind[ReplicaSet]:  isReplicaSetReady,
+	gvkByKind[Pod]:         isPodReady,
+	gvkByKind[Job]:         isJobReady,
+	gvkByKind[Service]:     isServiceReady,
+	gvkByKind[Ingress]:     isIngressReady,
+	gvkByKind[PVC]:         isPVCReady,
+	{Group: "apiextensions.k8s.io", Version: "v1beta1", Kind: "CustomResourceDefinition"}: isCRDBetaReady,
+	{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"}:       isCRDReady,
+}
+
+// RegisterReadyCheck wires a readiness function for a GroupVersionKind that isn't one
+// of this package's built-in kinds. Unregistered kinds added via AddResourceInfo fall
+// back to isConditionReady, which inspects status.conditions on the unstructured
+// object; without a resource.Info, checking them fails with ErrNoReadyCheck.
+func RegisterReadyCheck(gvk schema.GroupVersionKind, check readyCheckFunc) {
+	readyChecks[gvk] = check
+}
+
+// WatchState is the target state a tracked resource must reach before Watch() considers
+// it done. ReadyState is satisfied as soon as a resource reports itself ready (e.g. a
+// Deployment's new ReplicaSet has enough ready pods). AvailableState is stricter: it
+// additionally requires those pods to have held the Ready condition continuously for
+// the resource's MinReadySeconds, so a fleet that merely flapped ready for one tick
+// doesn't pass.
+type WatchState string
+
+const (
+	ReadyState     WatchState = "ready"
+	AvailableState WatchState = "available"
+)
+
+type trackerResource struct {
+	gvk                     schema.GroupVersionKind
+	name                    string
+	namespace               string
+	info                    *resource.Info
+	state                   WatchState
+	minReadySecondsOverride *time.Duration
+}
+
+// Tracker polls a fixed set of resources until they all report ready, or until timeout
+// elapses.
+type Tracker struct {
+	client                  kubernetes.Interface
+	interval                time.Duration
+	timeout                 time.Duration
+	minReadySecondsOverride *time.Duration
+	resources               []*trackerResource
+}
+
+func NewProgressTracker(client kubernetes.Interface, interval, timeout time.Duration) (*Tracker, error) {
+	if interval <= 0 || timeout <= 0 {
+		return nil, fmt.Errorf("interval and timeout have to be greater than 0")
+	}
+	return &Tracker{client: client, interval: interval, timeout: timeout}, nil
+}
+
+// WithMinReadySecondsOverride makes AvailableState checks use d instead of each
+// workload's Spec.MinReadySeconds, e.g. because a caller wants a stability window wider
+// than what the chart declares before running a post-install action.
+func (t *Tracker) WithMinReadySecondsOverride(d time.Duration) *Tracker {
+	t.minReadySecondsOverride = &d
+	return t
+}
+
+// AddResource registers a resource of a kind this package has built-in support for and
+// the state it must reach before Watch() considers it done.
+func (t *Tracker) AddResource(kind WatchableResource, namespace, name string, state WatchState) error {
+	gvk, ok := gvkByKind[kind]
+	if !ok {
+		return fmt.Errorf("unknown watchable resource kind %q", kind)
+	}
+	t.resources = append(t.resources, &trackerResource{
+		gvk: gvk, namespace: namespace, name: name, state: state, minReadySecondsOverride: t.minReadySecondsOverride,
+	})
+	return nil
+}
+
+// AddResourceInfo registers a resource identified by a cli-runtime resource.Info, which
+// is how CRD instances and other unstructured objects reach the tracker.
+func (t *Tracker) AddResourceInfo(gvk schema.GroupVersionKind, info *resource.Info) {
+	t.resources = append(t.resources, &trackerResource{
+		gvk: gvk, name: info.Name, namespace: info.Namespace, info: info, state: ReadyState,
+	})
+}
+
+// Watch polls every registered resource at t.interval until all of them are ready, the
+// context is cancelled, or t.timeout elapses.
+func (t *Tracker) Watch(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, t.timeout)
+	defer cancel()
+
+	pending := make(map[*trackerResource]bool, len(t.resources))
+	for _, r := range t.resources {
+		pending[r] = true
+	}
+
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for {
+		for r := range pending {
+			ready, err := t.checkReady(ctx, r)
+			if err != nil {
+				return err
+			}
+			if ready {
+				delete(pending, r)
+			}
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("progress tracker timed out after %s waiting for %d resource(s) to become ready", t.timeout, len(pending))
+		case <-ticker.C:
+		}
+	}
+}
+
+func (t *Tracker) checkReady(ctx context.Context, r *trackerResource) (bool, error) {
+	check, ok := readyChecks[r.gvk]
+	if !ok {
+		if r.info == nil {
+			return false, fmt.Errorf("%w: %s", ErrNoReadyCheck, r.gvk)
+		}
+		check = isConditionReady
+	}
+	return check(ctx, t.client, r)
+}
+
+// WaitUntilReady polls a single resource, identified by GroupVersionKind/namespace/name,
+// until its readyCheckFunc reports ready or timeout elapses. It's the same machinery
+// Watch uses internally, exposed for callers that discover a resource to wait on at
+// runtime (e.g. a Helm hook Job) instead of registering it upfront via AddResource.
+func WaitUntilReady(ctx context.Context, client kubernetes.Interface, gvk schema.GroupVersionKind, namespace, name string, interval, timeout time.Duration) error {
+	tracker, err := NewProgressTracker(client, interval, timeout)
+	if err != nil {
+		return err
+	}
+	tracker.resources = []*trackerResource{{gvk: gvk, namespace: namespace, name: name, state: ReadyState}}
+	return tracker.Watch(ctx)
+}